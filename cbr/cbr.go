@@ -0,0 +1,179 @@
+// Package cbr implements a providers.RateProvider backed by the Bank of
+// Russia's XML_daily.asp / XML_dynamic.asp endpoints. All CBR rates are
+// quoted against RUB, so any other pair is triangulated through it.
+package cbr
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+
+	"github.com/wtf-keaton/usd-rate-chart/metrics"
+	"github.com/wtf-keaton/usd-rate-chart/providers"
+)
+
+const (
+	dailyRatesURL   = "https://www.cbr.ru/scripts/XML_daily.asp"
+	dynamicRatesURL = "https://www.cbr.ru/scripts/XML_dynamic.asp?date_req1=%s&date_req2=%s&VAL_NM_RQ=%s"
+	httpTimeout     = 5 * time.Second
+	providerName    = "cbr"
+)
+
+// valuteCodes maps ISO currency codes to the CBR internal VAL_NM_RQ code
+// needed to request a dynamic history for that currency.
+var valuteCodes = map[string]string{
+	"USD": "R01235",
+	"EUR": "R01239",
+	"GBP": "R01035",
+	"CNY": "R01375",
+}
+
+// ValCurs is the root element of both CBR XML endpoints.
+type ValCurs struct {
+	Date    string   `xml:"Date,attr"`
+	Valutes []Valute `xml:"Valute"`
+	Records []Record `xml:"Record"`
+}
+
+// Record is one day's entry in an XML_dynamic.asp response.
+type Record struct {
+	Date  string `xml:"Date,attr"`
+	Value string `xml:"Value"`
+}
+
+// Valute is one currency's entry in an XML_daily.asp response.
+type Valute struct {
+	CharCode string `xml:"CharCode"`
+	Value    string `xml:"Value"`
+}
+
+// Provider fetches rates from the Bank of Russia.
+type Provider struct {
+	client *http.Client
+}
+
+// NewProvider creates a CBR-backed providers.RateProvider.
+func NewProvider() *Provider {
+	return &Provider{
+		client: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (p *Provider) Name() string {
+	return "cbr"
+}
+
+func (p *Provider) GetRate(ctx context.Context, base, quote string) (providers.Rate, error) {
+	valCurs, err := p.fetchXML(ctx, dailyRatesURL)
+	if err != nil {
+		return providers.Rate{}, err
+	}
+
+	rates := make(map[string]float64, len(valCurs.Valutes)+1)
+	rates["RUB"] = 1
+
+	for _, v := range valCurs.Valutes {
+		rate, err := parseRate(v.Value)
+		if err != nil {
+			continue
+		}
+		rates[v.CharCode] = rate
+	}
+
+	value, err := providers.CrossRate(rates, base, quote, providerName)
+	if err != nil {
+		return providers.Rate{}, err
+	}
+
+	return providers.Rate{Base: base, Quote: quote, Value: value, Date: valCurs.Date}, nil
+}
+
+func (p *Provider) GetHistory(ctx context.Context, base, quote string, from, to time.Time) ([]providers.HistoryPoint, error) {
+	if base != "RUB" && quote != "RUB" {
+		return nil, fmt.Errorf("cbr: history is only available against RUB, got %s/%s", base, quote)
+	}
+
+	foreign := base
+	invert := false
+	if base == "RUB" {
+		foreign = quote
+		invert = true
+	}
+
+	code, ok := valuteCodes[foreign]
+	if !ok {
+		return nil, fmt.Errorf("cbr: unsupported currency %q", foreign)
+	}
+
+	url := fmt.Sprintf(dynamicRatesURL, from.Format("02.01.2006"), to.Format("02.01.2006"), code)
+	valCurs, err := p.fetchXML(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]providers.HistoryPoint, 0, len(valCurs.Records))
+	for _, record := range valCurs.Records {
+		value, err := parseRate(record.Value)
+		if err != nil {
+			continue
+		}
+		if invert && value != 0 {
+			value = 1 / value
+		}
+		history = append(history, providers.HistoryPoint{Date: record.Date, Value: value})
+	}
+
+	return history, nil
+}
+
+func (p *Provider) fetchXML(ctx context.Context, url string) (valCurs *ValCurs, err error) {
+	start := time.Now()
+	defer func() {
+		metrics.ObserveLatency(providerName, time.Since(start))
+		metrics.RecordFetch(providerName, err)
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, httpTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cbr: failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cbr: failed to fetch data: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cbr: unexpected status code: %d", resp.StatusCode)
+	}
+
+	decoder := xml.NewDecoder(resp.Body)
+	decoder.CharsetReader = charset.NewReaderLabel
+
+	var result ValCurs
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("cbr: failed to parse XML: %w", err)
+	}
+
+	return &result, nil
+}
+
+func parseRate(rateStr string) (float64, error) {
+	rateStr = strings.Replace(rateStr, ",", ".", 1)
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse rate: %w", err)
+	}
+	return rate, nil
+}
@@ -0,0 +1,34 @@
+package cbr
+
+import "testing"
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{name: "dot decimal", in: "90.1234", want: 90.1234},
+		{name: "comma decimal", in: "90,1234", want: 90.1234},
+		{name: "not a number", in: "n/a", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRate(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseRate(%q) = %v, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRate(%q) returned unexpected error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseRate(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Package config resolves runtime configuration from flags and environment
+// variables, flags taking precedence when both are set.
+package config
+
+import (
+	"flag"
+	"os"
+	"strings"
+)
+
+// Config holds the settings needed to wire up CurrencyService.
+type Config struct {
+	// ProviderOrder lists provider names in fallback order, e.g. "cbr,ecb,exchangerate.host".
+	ProviderOrder []string
+	// EnablePprof mounts the /debug/pprof/* handlers when true.
+	EnablePprof bool
+	// CacheBackend selects the Cache implementation: "memory" or "redis".
+	CacheBackend string
+	// RedisAddr is the Redis server address, required when CacheBackend is "redis".
+	RedisAddr string
+	// AdminToken is the bearer token required by /admin/* endpoints. Empty disables them.
+	AdminToken string
+}
+
+// Load parses flags/env into a Config. It is safe to call at most once per
+// process since it registers flags on flag.CommandLine.
+func Load() Config {
+	defaultOrder := EnvOr("PROVIDER_ORDER", "cbr,ecb,exchangerate.host")
+	defaultPprof := EnvOr("ENABLE_PPROF", "false") == "true"
+
+	providerOrder := flag.String("provider-order", defaultOrder,
+		"comma-separated provider fallback order (cbr, ecb, exchangerate.host)")
+	enablePprof := flag.Bool("enable-pprof", defaultPprof,
+		"mount /debug/pprof/* handlers")
+	cacheBackend := flag.String("cache-backend", EnvOr("CACHE_BACKEND", "memory"),
+		"cache backend to use (memory or redis)")
+	redisAddr := flag.String("redis-addr", EnvOr("REDIS_ADDR", ""),
+		"redis server address, required when cache-backend=redis")
+	adminToken := flag.String("admin-token", EnvOr("ADMIN_TOKEN", ""),
+		"bearer token required by /admin/* endpoints")
+
+	flag.Parse()
+
+	return Config{
+		ProviderOrder: splitCSV(*providerOrder),
+		EnablePprof:   *enablePprof,
+		CacheBackend:  *cacheBackend,
+		RedisAddr:     *redisAddr,
+		AdminToken:    *adminToken,
+	}
+}
+
+// EnvOr returns the value of the given environment variable, or fallback if unset/empty.
+func EnvOr(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+func splitCSV(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/wtf-keaton/usd-rate-chart/service"
+)
+
+// AdminHandler exposes operational endpoints protected by a bearer token.
+type AdminHandler struct {
+	service *service.CurrencyService
+	token   string
+}
+
+// NewAdminHandler creates an AdminHandler. token is the required bearer
+// token; an empty token disables every route it registers.
+func NewAdminHandler(svc *service.CurrencyService, token string) *AdminHandler {
+	return &AdminHandler{service: svc, token: token}
+}
+
+// RegisterRoutes mounts the /admin endpoints on router.
+func (h *AdminHandler) RegisterRoutes(router fiber.Router) {
+	group := router.Group("/admin", h.requireBearerToken)
+	group.Post("/cache/purge", h.PurgeCache)
+}
+
+func (h *AdminHandler) requireBearerToken(c *fiber.Ctx) error {
+	if h.token == "" {
+		return respondError(c, fiber.StatusServiceUnavailable, "admin_disabled", "admin endpoints are disabled")
+	}
+
+	header := c.Get(fiber.HeaderAuthorization)
+	token := strings.TrimPrefix(header, "Bearer ")
+	if token == "" || token == header || token != h.token {
+		return respondError(c, fiber.StatusUnauthorized, "unauthorized", "invalid or missing bearer token")
+	}
+
+	return c.Next()
+}
+
+type purgeRequest struct {
+	Prefix string `json:"prefix"`
+}
+
+func (h *AdminHandler) PurgeCache(c *fiber.Ctx) error {
+	var req purgeRequest
+	_ = c.BodyParser(&req) // prefix is optional; an empty body purges everything
+
+	if err := h.service.InvalidateCache(c.Context(), req.Prefix); err != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", err.Error())
+	}
+
+	return c.JSON(fiber.Map{"purged_prefix": req.Prefix})
+}
@@ -0,0 +1,161 @@
+// Package handlers wires Fiber routes to the repositories/services that
+// back them.
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/wtf-keaton/usd-rate-chart/models"
+	"github.com/wtf-keaton/usd-rate-chart/repositories"
+)
+
+// AlertHandler exposes REST CRUD for user-defined rate alerts.
+type AlertHandler struct {
+	repo *repositories.AlertRepository
+}
+
+// NewAlertHandler creates an AlertHandler backed by repo.
+func NewAlertHandler(repo *repositories.AlertRepository) *AlertHandler {
+	return &AlertHandler{repo: repo}
+}
+
+// RegisterRoutes mounts the /alerts CRUD endpoints on router.
+func (h *AlertHandler) RegisterRoutes(router fiber.Router) {
+	group := router.Group("/alerts")
+	group.Post("/", h.Create)
+	group.Get("/", h.List)
+	group.Get("/:id", h.Get)
+	group.Put("/:id", h.Update)
+	group.Delete("/:id", h.Delete)
+}
+
+type alertRequest struct {
+	Currency   string           `json:"currency"`
+	Threshold  float64          `json:"threshold"`
+	Direction  models.Direction `json:"direction"`
+	WebhookURL string           `json:"webhook_url"`
+}
+
+func (r alertRequest) validate() error {
+	if r.Currency == "" {
+		return errors.New("currency is required")
+	}
+	if _, _, err := models.SplitCurrencyPair(r.Currency); err != nil {
+		return err
+	}
+	if r.Direction != models.DirectionAbove && r.Direction != models.DirectionBelow {
+		return errors.New("direction must be \"above\" or \"below\"")
+	}
+	if r.WebhookURL == "" {
+		return errors.New("webhook_url is required")
+	}
+	if err := validateWebhookURL(r.WebhookURL); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *AlertHandler) Create(c *fiber.Ctx) error {
+	var req alertRequest
+	if err := c.BodyParser(&req); err != nil {
+		return respondError(c, fiber.StatusBadRequest, "invalid_body", "invalid request body")
+	}
+	if err := req.validate(); err != nil {
+		return respondError(c, fiber.StatusBadRequest, "validation_failed", err.Error())
+	}
+
+	alert, err := h.repo.Create(models.Alert{
+		Currency:   req.Currency,
+		Threshold:  req.Threshold,
+		Direction:  req.Direction,
+		WebhookURL: req.WebhookURL,
+	})
+	if err != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", err.Error())
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(alert)
+}
+
+func (h *AlertHandler) List(c *fiber.Ctx) error {
+	alerts, err := h.repo.GetAll()
+	if err != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", err.Error())
+	}
+	return c.JSON(alerts)
+}
+
+func (h *AlertHandler) Get(c *fiber.Ctx) error {
+	id, err := parseID(c)
+	if err != nil {
+		return respondError(c, fiber.StatusBadRequest, "invalid_id", err.Error())
+	}
+
+	alert, err := h.repo.GetByID(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return respondError(c, fiber.StatusNotFound, "not_found", "alert not found")
+	}
+	if err != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", err.Error())
+	}
+
+	return c.JSON(alert)
+}
+
+func (h *AlertHandler) Update(c *fiber.Ctx) error {
+	id, err := parseID(c)
+	if err != nil {
+		return respondError(c, fiber.StatusBadRequest, "invalid_id", err.Error())
+	}
+
+	var req alertRequest
+	if err := c.BodyParser(&req); err != nil {
+		return respondError(c, fiber.StatusBadRequest, "invalid_body", "invalid request body")
+	}
+	if err := req.validate(); err != nil {
+		return respondError(c, fiber.StatusBadRequest, "validation_failed", err.Error())
+	}
+
+	alert := models.Alert{
+		ID:         id,
+		Currency:   req.Currency,
+		Threshold:  req.Threshold,
+		Direction:  req.Direction,
+		WebhookURL: req.WebhookURL,
+	}
+
+	if err := h.repo.Update(alert); errors.Is(err, sql.ErrNoRows) {
+		return respondError(c, fiber.StatusNotFound, "not_found", "alert not found")
+	} else if err != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", err.Error())
+	}
+
+	return c.JSON(alert)
+}
+
+func (h *AlertHandler) Delete(c *fiber.Ctx) error {
+	id, err := parseID(c)
+	if err != nil {
+		return respondError(c, fiber.StatusBadRequest, "invalid_id", err.Error())
+	}
+
+	if err := h.repo.Delete(id); errors.Is(err, sql.ErrNoRows) {
+		return respondError(c, fiber.StatusNotFound, "not_found", "alert not found")
+	} else if err != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", err.Error())
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func parseID(c *fiber.Ctx) (int64, error) {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid alert id")
+	}
+	return id, nil
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/wtf-keaton/usd-rate-chart/pkg/badge"
+	"github.com/wtf-keaton/usd-rate-chart/service"
+)
+
+// BadgeHandler renders shields.io-style SVG badges of the current rate.
+type BadgeHandler struct {
+	service *service.CurrencyService
+}
+
+// NewBadgeHandler creates a BadgeHandler backed by svc.
+func NewBadgeHandler(svc *service.CurrencyService) *BadgeHandler {
+	return &BadgeHandler{service: svc}
+}
+
+// RegisterRoutes mounts GET /badge/:currency.svg on router.
+func (h *BadgeHandler) RegisterRoutes(router fiber.Router) {
+	router.Get("/badge/:currency", h.Badge)
+}
+
+func (h *BadgeHandler) Badge(c *fiber.Ctx) error {
+	currency := strings.ToUpper(strings.TrimSuffix(c.Params("currency"), ".svg"))
+	quote := strings.ToUpper(c.Query("quote", "RUB"))
+
+	rate, err := h.service.GetRate(c.Context(), currency, quote)
+	if err != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", err.Error())
+	}
+
+	label := c.Query("label", currency)
+	value := fmt.Sprintf("%.2f %s", rate.Value, currencySymbol(quote))
+
+	b := badge.Badge{
+		Label:      label,
+		Value:      value,
+		LabelColor: c.Query("labelColor"),
+		ValueColor: c.Query("color"),
+		Style:      badge.ParseStyle(c.Query("style")),
+	}
+
+	if c.Query("arrow") == "true" {
+		b.Arrow = h.trendArrow(c, currency, quote, rate.Value)
+	}
+
+	c.Set(fiber.HeaderContentType, "image/svg+xml")
+	c.Set(fiber.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(service.CacheExpiration.Seconds())))
+
+	return c.SendString(badge.Render(b))
+}
+
+// trendArrow compares the current rate against its value 24h ago, returning
+// an empty ArrowDirection if the history is unavailable.
+func (h *BadgeHandler) trendArrow(c *fiber.Ctx, base, quote string, current float64) badge.ArrowDirection {
+	to := time.Now()
+	from := to.AddDate(0, 0, -1)
+
+	history, err := h.service.GetHistory(c.Context(), base, quote, from, to)
+	if err != nil || len(history) == 0 {
+		return ""
+	}
+
+	previous := history[0].Value
+	if current >= previous {
+		return badge.ArrowUp
+	}
+	return badge.ArrowDown
+}
+
+func currencySymbol(code string) string {
+	switch code {
+	case "RUB":
+		return "₽"
+	case "EUR":
+		return "€"
+	case "USD":
+		return "$"
+	case "GBP":
+		return "£"
+	default:
+		return code
+	}
+}
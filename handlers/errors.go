@@ -0,0 +1,22 @@
+package handlers
+
+import "github.com/gofiber/fiber/v2"
+
+// ErrorResponse is the JSON envelope every handler in this package returns
+// on failure, so clients can rely on a single error shape.
+type ErrorResponse struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+	Trace string `json:"trace"`
+}
+
+// respondError writes a status-coded ErrorResponse, tagging it with the
+// request's trace id so it can be correlated with server-side logs.
+func respondError(c *fiber.Ctx, status int, code, message string) error {
+	trace, _ := c.Locals("requestid").(string)
+	return c.Status(status).JSON(ErrorResponse{
+		Error: message,
+		Code:  code,
+		Trace: trace,
+	})
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/wtf-keaton/usd-rate-chart/providers"
+	"github.com/wtf-keaton/usd-rate-chart/service"
+)
+
+const maxHistoryRange = 10 * 365 * 24 * time.Hour
+
+var periodDurations = map[string]time.Duration{
+	"1d": 24 * time.Hour,
+	"1w": 7 * 24 * time.Hour,
+	"1m": 30 * 24 * time.Hour,
+	"1y": 365 * 24 * time.Hour,
+}
+
+// RateHandler exposes the rate/history endpoints backed by CurrencyService.
+type RateHandler struct {
+	service *service.CurrencyService
+}
+
+// NewRateHandler creates a RateHandler backed by svc.
+func NewRateHandler(svc *service.CurrencyService) *RateHandler {
+	return &RateHandler{service: svc}
+}
+
+// RegisterRoutes mounts the index page and rate/history endpoints on router.
+func (h *RateHandler) RegisterRoutes(router fiber.Router) {
+	router.Get("/", h.Index)
+	router.Get("/history", h.DefaultHistory)
+	router.Get("/rate/:base/:quote", h.Rate)
+	router.Get("/history/:base/:quote", h.History)
+}
+
+func (h *RateHandler) Index(c *fiber.Ctx) error {
+	rate, err := h.service.GetRate(c.Context(), "USD", "RUB")
+	course := rate.Value
+	if err != nil {
+		course = 0
+	}
+
+	return c.Render("index", fiber.Map{
+		"course": course,
+		"date":   rate.Date,
+	})
+}
+
+func (h *RateHandler) DefaultHistory(c *fiber.Ctx) error {
+	return h.serveHistory(c, "USD", "RUB")
+}
+
+func (h *RateHandler) Rate(c *fiber.Ctx) error {
+	base := strings.ToUpper(c.Params("base"))
+	quote := strings.ToUpper(c.Params("quote"))
+
+	rate, err := h.service.GetRate(c.Context(), base, quote)
+	if err != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", err.Error())
+	}
+	return c.JSON(rate)
+}
+
+func (h *RateHandler) History(c *fiber.Ctx) error {
+	base := strings.ToUpper(c.Params("base"))
+	quote := strings.ToUpper(c.Params("quote"))
+
+	return h.serveHistory(c, base, quote)
+}
+
+func (h *RateHandler) serveHistory(c *fiber.Ctx, base, quote string) error {
+	from, to, err := parseHistoryRange(c)
+	if err != nil {
+		return respondError(c, fiber.StatusBadRequest, "invalid_range", err.Error())
+	}
+
+	history, fetchErr := h.service.GetHistory(c.Context(), base, quote, from, to)
+	if fetchErr != nil {
+		return respondError(c, fiber.StatusInternalServerError, "internal_error", fetchErr.Error())
+	}
+
+	if c.Query("format") == "csv" {
+		return writeHistoryCSV(c, history)
+	}
+
+	return c.JSON(history)
+}
+
+// parseHistoryRange resolves the from/to window for a history request from
+// the "from"/"to"/"period" query params, defaulting to the last 7 days, and
+// validates that from <= to, the range doesn't exceed 10 years, and neither
+// bound is in the future.
+func parseHistoryRange(c *fiber.Ctx) (from, to time.Time, err error) {
+	now := time.Now()
+	to = now
+	from = now.AddDate(0, 0, -7)
+
+	if period := c.Query("period"); period != "" {
+		duration, ok := periodDurations[period]
+		if !ok {
+			return time.Time{}, time.Time{}, invalidRangeError("period must be one of 1d, 1w, 1m, 1y")
+		}
+		from = now.Add(-duration)
+	}
+
+	if fromParam := c.Query("from"); fromParam != "" {
+		parsed, parseErr := time.Parse("2006-01-02", fromParam)
+		if parseErr != nil {
+			return time.Time{}, time.Time{}, invalidRangeError("invalid from date, expected YYYY-MM-DD")
+		}
+		from = parsed
+	}
+	if toParam := c.Query("to"); toParam != "" {
+		parsed, parseErr := time.Parse("2006-01-02", toParam)
+		if parseErr != nil {
+			return time.Time{}, time.Time{}, invalidRangeError("invalid to date, expected YYYY-MM-DD")
+		}
+		to = parsed
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, invalidRangeError("from must be on or before to")
+	}
+	if to.Sub(from) > maxHistoryRange {
+		return time.Time{}, time.Time{}, invalidRangeError("range must not exceed 10 years")
+	}
+	if from.After(now) || to.After(now) {
+		return time.Time{}, time.Time{}, invalidRangeError("from/to must not be in the future")
+	}
+
+	return from, to, nil
+}
+
+func invalidRangeError(message string) error {
+	return errors.New(message)
+}
+
+func writeHistoryCSV(c *fiber.Ctx, history []providers.HistoryPoint) error {
+	c.Set(fiber.HeaderContentType, "text/csv")
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="history.csv"`)
+
+	writer := csv.NewWriter(c.Response().BodyWriter())
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"date", "rate"}); err != nil {
+		return err
+	}
+
+	for _, point := range history {
+		if err := writer.Write([]string{point.Date, strconv.FormatFloat(point.Value, 'f', -1, 64)}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
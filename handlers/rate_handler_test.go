@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func parseHistoryRangeForQuery(query string) (from, to time.Time, err error) {
+	app := fiber.New()
+	app.Get("/history", func(c *fiber.Ctx) error {
+		from, to, err = parseHistoryRange(c)
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	req := httptest.NewRequest(fiber.MethodGet, "/history"+query, nil)
+	resp, reqErr := app.Test(req)
+	if reqErr != nil {
+		return time.Time{}, time.Time{}, reqErr
+	}
+	defer resp.Body.Close()
+
+	return from, to, err
+}
+
+func TestParseHistoryRangeDefaultsToLastWeek(t *testing.T) {
+	from, to, err := parseHistoryRangeForQuery("")
+	if err != nil {
+		t.Fatalf("parseHistoryRange returned unexpected error: %v", err)
+	}
+
+	if got := to.Sub(from); got < 6*24*time.Hour || got > 8*24*time.Hour {
+		t.Errorf("default range = %v, want ~7 days", got)
+	}
+}
+
+func TestParseHistoryRangePeriod(t *testing.T) {
+	from, to, err := parseHistoryRangeForQuery("?period=1m")
+	if err != nil {
+		t.Fatalf("parseHistoryRange returned unexpected error: %v", err)
+	}
+
+	if got := to.Sub(from); got < 29*24*time.Hour || got > 31*24*time.Hour {
+		t.Errorf("1m period range = %v, want ~30 days", got)
+	}
+}
+
+func TestParseHistoryRangeExplicitFromTo(t *testing.T) {
+	from, to, err := parseHistoryRangeForQuery("?from=2024-01-01&to=2024-01-10")
+	if err != nil {
+		t.Fatalf("parseHistoryRange returned unexpected error: %v", err)
+	}
+
+	if from.Format("2006-01-02") != "2024-01-01" || to.Format("2006-01-02") != "2024-01-10" {
+		t.Errorf("got from=%v to=%v, want 2024-01-01/2024-01-10", from, to)
+	}
+}
+
+func TestParseHistoryRangeInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "unknown period", query: "?period=1h"},
+		{name: "malformed from date", query: "?from=not-a-date"},
+		{name: "malformed to date", query: "?to=not-a-date"},
+		{name: "from after to", query: "?from=2024-01-10&to=2024-01-01"},
+		{name: "range exceeds 10 years", query: "?from=2000-01-01&to=2024-01-01"},
+		{name: "to in the future", query: "?to=2999-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := parseHistoryRangeForQuery(tt.query)
+			if err == nil {
+				t.Fatalf("parseHistoryRange(%q) = nil error, want error", tt.query)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+)
+
+// validateWebhookURL rejects anything that isn't an https URL resolving to a
+// public IP address, so an alert can't be used to make the scheduler POST to
+// loopback/link-local/private infrastructure (e.g. cloud metadata services).
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook_url: %w", err)
+	}
+	if u.Scheme != "https" {
+		return errors.New("webhook_url must use https")
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return errors.New("webhook_url must include a host")
+	}
+
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return fmt.Errorf("webhook_url host could not be resolved: %w", err)
+	}
+	for _, ip := range ips {
+		if !isPublicIP(ip) {
+			return errors.New("webhook_url must not resolve to a private, loopback, or link-local address")
+		}
+	}
+
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsUnspecified() && !ip.IsMulticast()
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net"
+	"testing"
+)
+
+func mustParseIP(t *testing.T, s string) net.IP {
+	t.Helper()
+	ip := net.ParseIP(s)
+	if ip == nil {
+		t.Fatalf("net.ParseIP(%q) = nil", s)
+	}
+	return ip
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "https to public IP literal", url: "https://93.184.216.34/hook", wantErr: false},
+		{name: "http scheme rejected", url: "http://93.184.216.34/hook", wantErr: true},
+		{name: "loopback rejected", url: "https://127.0.0.1/hook", wantErr: true},
+		{name: "link-local metadata address rejected", url: "https://169.254.169.254/latest/meta-data", wantErr: true},
+		{name: "private range rejected", url: "https://10.0.0.5/hook", wantErr: true},
+		{name: "malformed URL rejected", url: "://not-a-url", wantErr: true},
+		{name: "no host rejected", url: "https:///hook", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWebhookURL(tt.url)
+			if tt.wantErr && err == nil {
+				t.Fatalf("validateWebhookURL(%q) = nil, want error", tt.url)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("validateWebhookURL(%q) returned unexpected error: %v", tt.url, err)
+			}
+		})
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		name string
+		ip   string
+		want bool
+	}{
+		{name: "public IPv4", ip: "93.184.216.34", want: true},
+		{name: "loopback", ip: "127.0.0.1", want: false},
+		{name: "private 10/8", ip: "10.1.2.3", want: false},
+		{name: "private 192.168/16", ip: "192.168.1.1", want: false},
+		{name: "link-local", ip: "169.254.1.1", want: false},
+		{name: "unspecified", ip: "0.0.0.0", want: false},
+		{name: "multicast", ip: "224.0.0.1", want: false},
+		{name: "loopback IPv6", ip: "::1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip := mustParseIP(t, tt.ip)
+			if got := isPublicIP(ip); got != tt.want {
+				t.Errorf("isPublicIP(%q) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Package metrics registers and updates the Prometheus collectors exposed
+// on /metrics.
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	fetchTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "usd_rate_chart_upstream_fetch_total",
+		Help: "Count of upstream provider fetches, labeled by provider and outcome.",
+	}, []string{"provider", "outcome"})
+
+	upstreamLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "usd_rate_chart_upstream_latency_seconds",
+		Help:    "Latency of upstream provider fetches.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"provider"})
+
+	cacheTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "usd_rate_chart_cache_total",
+		Help: "Count of cache lookups, labeled by cache key prefix and hit/miss.",
+	}, []string{"key", "result"})
+
+	lastRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "usd_rate_chart_last_rate",
+		Help: "Last known rate for a currency pair.",
+	}, []string{"pair"})
+
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "usd_rate_chart_http_requests_total",
+		Help: "Count of HTTP requests, labeled by route, method and status.",
+	}, []string{"route", "method", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "usd_rate_chart_http_request_duration_seconds",
+		Help:    "Latency of HTTP requests, labeled by route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route"})
+)
+
+// RecordFetch records the outcome of an upstream provider fetch.
+func RecordFetch(provider string, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+	fetchTotal.WithLabelValues(provider, outcome).Inc()
+}
+
+// ObserveLatency records how long an upstream provider fetch took.
+func ObserveLatency(provider string, duration time.Duration) {
+	upstreamLatency.WithLabelValues(provider).Observe(duration.Seconds())
+}
+
+// RecordCache records a cache lookup as a hit or miss. cacheKey is bucketed
+// by its prefix (the part before the first ':') to keep cardinality bounded.
+func RecordCache(cacheKey string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	cacheTotal.WithLabelValues(keyPrefix(cacheKey), result).Inc()
+}
+
+// SetLastRate records the most recently fetched rate for a currency pair.
+func SetLastRate(base, quote string, rate float64) {
+	lastRate.WithLabelValues(base + "/" + quote).Set(rate)
+}
+
+// RecordHTTPRequest records a completed HTTP request.
+func RecordHTTPRequest(route, method, status string, duration time.Duration) {
+	httpRequestsTotal.WithLabelValues(route, method, status).Inc()
+	httpRequestDuration.WithLabelValues(route).Observe(duration.Seconds())
+}
+
+func keyPrefix(cacheKey string) string {
+	if i := strings.IndexByte(cacheKey, ':'); i != -1 {
+		return cacheKey[:i]
+	}
+	return cacheKey
+}
+
+// Handler returns the http.Handler that serves the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
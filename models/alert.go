@@ -0,0 +1,53 @@
+// Package models holds the persisted domain types shared by the
+// repositories, handlers, and scheduler packages.
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Direction is the side of the threshold an alert should fire on.
+type Direction string
+
+const (
+	DirectionAbove Direction = "above"
+	DirectionBelow Direction = "below"
+)
+
+// Alert is a user-defined rate watch: when Currency crosses Threshold in
+// Direction, WebhookURL is notified.
+type Alert struct {
+	ID         int64     `json:"id"`
+	Currency   string    `json:"currency"`
+	Threshold  float64   `json:"threshold"`
+	Direction  Direction `json:"direction"`
+	WebhookURL string    `json:"webhook_url"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Triggered reports whether rate crosses the alert's threshold in its
+// configured direction.
+func (a Alert) Triggered(rate float64) bool {
+	switch a.Direction {
+	case DirectionAbove:
+		return rate >= a.Threshold
+	case DirectionBelow:
+		return rate <= a.Threshold
+	default:
+		return false
+	}
+}
+
+// SplitCurrencyPair splits an Alert.Currency of the form "BASE/QUOTE" (e.g.
+// "USD/RUB") into its two uppercased ISO codes. It is the single source of
+// truth for the format alert currencies must use, shared by the alert
+// handler's validation and the scheduler's rate lookup.
+func SplitCurrencyPair(currency string) (base, quote string, err error) {
+	parts := strings.SplitN(currency, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid currency pair %q, expected BASE/QUOTE", currency)
+	}
+	return strings.ToUpper(parts[0]), strings.ToUpper(parts[1]), nil
+}
@@ -0,0 +1,65 @@
+package models
+
+import "testing"
+
+func TestAlertTriggered(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction Direction
+		threshold float64
+		rate      float64
+		want      bool
+	}{
+		{name: "above triggers when rate exceeds threshold", direction: DirectionAbove, threshold: 90, rate: 91, want: true},
+		{name: "above triggers when rate equals threshold", direction: DirectionAbove, threshold: 90, rate: 90, want: true},
+		{name: "above does not trigger below threshold", direction: DirectionAbove, threshold: 90, rate: 89, want: false},
+		{name: "below triggers when rate is under threshold", direction: DirectionBelow, threshold: 90, rate: 89, want: true},
+		{name: "below triggers when rate equals threshold", direction: DirectionBelow, threshold: 90, rate: 90, want: true},
+		{name: "below does not trigger above threshold", direction: DirectionBelow, threshold: 90, rate: 91, want: false},
+		{name: "unknown direction never triggers", direction: Direction("sideways"), threshold: 90, rate: 91, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert := Alert{Direction: tt.direction, Threshold: tt.threshold}
+			if got := alert.Triggered(tt.rate); got != tt.want {
+				t.Errorf("Triggered(%v) = %v, want %v", tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitCurrencyPair(t *testing.T) {
+	tests := []struct {
+		name      string
+		currency  string
+		wantBase  string
+		wantQuote string
+		wantErr   bool
+	}{
+		{name: "valid pair", currency: "USD/RUB", wantBase: "USD", wantQuote: "RUB"},
+		{name: "lowercases normalized to upper", currency: "usd/rub", wantBase: "USD", wantQuote: "RUB"},
+		{name: "missing separator", currency: "USD", wantErr: true},
+		{name: "empty base", currency: "/RUB", wantErr: true},
+		{name: "empty quote", currency: "USD/", wantErr: true},
+		{name: "empty string", currency: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, quote, err := SplitCurrencyPair(tt.currency)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("SplitCurrencyPair(%q) = (%q, %q), want error", tt.currency, base, quote)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SplitCurrencyPair(%q) returned unexpected error: %v", tt.currency, err)
+			}
+			if base != tt.wantBase || quote != tt.wantQuote {
+				t.Errorf("SplitCurrencyPair(%q) = (%q, %q), want (%q, %q)", tt.currency, base, quote, tt.wantBase, tt.wantQuote)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Package badge renders shields.io-style two-panel SVG badges.
+package badge
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Style selects the visual rendering of a badge, matching shields.io's
+// naming.
+type Style string
+
+const (
+	StyleFlat       Style = "flat"
+	StyleFlatSquare Style = "flat-square"
+	StylePlastic    Style = "plastic"
+)
+
+// ParseStyle maps a ?style= query value to a Style, defaulting to StyleFlat
+// for anything unrecognized.
+func ParseStyle(s string) Style {
+	switch Style(s) {
+	case StyleFlatSquare:
+		return StyleFlatSquare
+	case StylePlastic:
+		return StylePlastic
+	default:
+		return StyleFlat
+	}
+}
+
+// ArrowDirection renders a colored trend glyph next to the value panel.
+type ArrowDirection string
+
+const (
+	ArrowUp   ArrowDirection = "up"
+	ArrowDown ArrowDirection = "down"
+)
+
+const (
+	height       = 20
+	fontSize     = 11
+	horizPadding = 6
+	cornerRadius = 3
+)
+
+// hexColorPattern and namedColorPattern are the only LabelColor/ValueColor
+// shapes colorOrDefault will interpolate into the rendered SVG's fill
+// attribute; anything else (including markup-breaking characters) falls
+// back to the default color.
+var (
+	hexColorPattern   = regexp.MustCompile(`^[0-9A-Fa-f]{3,8}$`)
+	namedColorPattern = regexp.MustCompile(`^[A-Za-z]+$`)
+)
+
+// Badge describes everything needed to render one SVG badge.
+type Badge struct {
+	Label      string
+	Value      string
+	LabelColor string
+	ValueColor string
+	Style      Style
+	Arrow      ArrowDirection // empty disables the arrow glyph
+}
+
+// Render produces the minified SVG for b.
+func Render(b Badge) string {
+	labelWidth := textWidth(b.Label) + horizPadding*2
+	value := b.Value
+	if b.Arrow != "" {
+		if b.Arrow == ArrowUp {
+			value += " ▲"
+		} else {
+			value += " ▼"
+		}
+	}
+	valueWidth := textWidth(value) + horizPadding*2
+
+	totalWidth := labelWidth + valueWidth
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%d" role="img" aria-label="%s: %s">`,
+		totalWidth, height, escape(b.Label), escape(b.Value))
+
+	sb.WriteString(renderBackground(b.Style, totalWidth, labelWidth, b.LabelColor, b.ValueColor))
+	sb.WriteString(renderText(b.Label, labelWidth/2, "#fff"))
+	sb.WriteString(renderText(value, labelWidth+valueWidth/2, arrowAwareColor(b)))
+
+	sb.WriteString(`</svg>`)
+
+	return minify(sb.String())
+}
+
+func renderBackground(style Style, totalWidth, labelWidth float64, labelColor, valueColor string) string {
+	var sb strings.Builder
+
+	radius := cornerRadius
+	if style == StyleFlatSquare {
+		radius = 0
+	}
+
+	fmt.Fprintf(&sb, `<clipPath id="r"><rect width="%.0f" height="%d" rx="%d" fill="#fff"/></clipPath>`, totalWidth, height, radius)
+	sb.WriteString(`<g clip-path="url(#r)">`)
+	fmt.Fprintf(&sb, `<rect width="%.0f" height="%d" fill="%s"/>`, labelWidth, height, colorOrDefault(labelColor, "#555"))
+	fmt.Fprintf(&sb, `<rect x="%.0f" width="%.0f" height="%d" fill="%s"/>`, labelWidth, totalWidth-labelWidth, height, colorOrDefault(valueColor, "#4c1"))
+
+	if style == StylePlastic {
+		fmt.Fprintf(&sb, `<rect width="%.0f" height="%d" fill="url(#shine)"/>`, totalWidth, height)
+		sb.WriteString(`<linearGradient id="shine" x2="0" y2="100%"><stop offset="0" stop-color="#fff" stop-opacity=".25"/><stop offset="1" stop-opacity=".15"/></linearGradient>`)
+	} else if style == StyleFlat {
+		fmt.Fprintf(&sb, `<rect width="%.0f" height="%d" fill="url(#shine)"/>`, totalWidth, height)
+		sb.WriteString(`<linearGradient id="shine" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".15"/><stop offset="1" stop-opacity=".05"/></linearGradient>`)
+	}
+
+	sb.WriteString(`</g>`)
+
+	return sb.String()
+}
+
+func renderText(text string, x float64, color string) string {
+	return fmt.Sprintf(
+		`<text x="%.1f" y="%d" fill="%s" font-family="Verdana,Geneva,sans-serif" font-size="%d" text-anchor="middle">%s</text>`,
+		x, height/2+4, color, fontSize, escape(text),
+	)
+}
+
+func arrowAwareColor(b Badge) string {
+	switch b.Arrow {
+	case ArrowUp:
+		return "#9f9"
+	case ArrowDown:
+		return "#f99"
+	default:
+		return "#fff"
+	}
+}
+
+func colorOrDefault(color, fallback string) string {
+	if color == "" {
+		return fallback
+	}
+	if hex := strings.TrimPrefix(color, "#"); hexColorPattern.MatchString(hex) {
+		return "#" + hex
+	}
+	if namedColorPattern.MatchString(color) {
+		return color
+	}
+	return fallback
+}
+
+func escape(s string) string {
+	replacer := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+	return replacer.Replace(s)
+}
+
+// minify strips the indentation and newlines a hand-written template would
+// otherwise emit, keeping the wire payload small.
+func minify(svg string) string {
+	var sb strings.Builder
+	for _, line := range strings.Split(svg, "\n") {
+		sb.WriteString(strings.TrimSpace(line))
+	}
+	return sb.String()
+}
@@ -0,0 +1,103 @@
+package badge
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTextWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want float64
+	}{
+		{name: "empty string", in: "", want: 0},
+		{name: "known runes sum their widths", in: "USD", want: charWidths['U'] + charWidths['S'] + charWidths['D']},
+		{name: "unknown runes fall back", in: "Ж", want: fallbackWidth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := textWidth(tt.in); got != tt.want {
+				t.Errorf("textWidth(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStyle(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Style
+	}{
+		{in: "flat-square", want: StyleFlatSquare},
+		{in: "plastic", want: StylePlastic},
+		{in: "flat", want: StyleFlat},
+		{in: "bogus", want: StyleFlat},
+		{in: "", want: StyleFlat},
+	}
+
+	for _, tt := range tests {
+		if got := ParseStyle(tt.in); got != tt.want {
+			t.Errorf("ParseStyle(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRenderContainsLabelAndValue(t *testing.T) {
+	svg := Render(Badge{Label: "USD/RUB", Value: "90.12", Style: StyleFlat})
+
+	if !strings.Contains(svg, "USD/RUB") {
+		t.Errorf("Render output missing label: %s", svg)
+	}
+	if !strings.Contains(svg, "90.12") {
+		t.Errorf("Render output missing value: %s", svg)
+	}
+	if !strings.Contains(svg, "<svg") || !strings.Contains(svg, "</svg>") {
+		t.Errorf("Render output is not a well-formed SVG: %s", svg)
+	}
+}
+
+func TestRenderEscapesSpecialChars(t *testing.T) {
+	svg := Render(Badge{Label: "<a>&\"", Value: "x"})
+
+	if strings.Contains(svg, "<a>") {
+		t.Errorf("Render did not escape label: %s", svg)
+	}
+	if !strings.Contains(svg, "&lt;a&gt;&amp;&quot;") {
+		t.Errorf("Render did not produce expected escaped label: %s", svg)
+	}
+}
+
+func TestColorOrDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		color    string
+		fallback string
+		want     string
+	}{
+		{name: "empty uses fallback", color: "", fallback: "#555", want: "#555"},
+		{name: "bare hex gets hash prefix", color: "4c1", fallback: "#555", want: "#4c1"},
+		{name: "hash-prefixed hex kept as-is", color: "#4c1af0", fallback: "#555", want: "#4c1af0"},
+		{name: "named color kept as-is", color: "orange", fallback: "#555", want: "orange"},
+		{name: "injection payload falls back", color: `"/><script>alert(1)</script><rect fill="`, fallback: "#555", want: "#555"},
+		{name: "non-hex non-letters falls back", color: "4c1;evil", fallback: "#555", want: "#555"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := colorOrDefault(tt.color, tt.fallback); got != tt.want {
+				t.Errorf("colorOrDefault(%q, %q) = %q, want %q", tt.color, tt.fallback, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderRejectsColorInjection(t *testing.T) {
+	payload := `"/><script>alert(document.domain)</script><rect fill="`
+	svg := Render(Badge{Label: "USD", Value: "90.12", LabelColor: payload, ValueColor: payload})
+
+	if strings.Contains(svg, "<script>") {
+		t.Errorf("Render interpolated an unescaped color into the SVG: %s", svg)
+	}
+}
@@ -0,0 +1,37 @@
+package badge
+
+// charWidths is a bundled subset of Verdana 11px character advance widths,
+// matching the metrics shields.io uses for its badge text layout. Runes not
+// present fall back to fallbackWidth.
+var charWidths = map[rune]float64{
+	' ': 3.5, '!': 4.0, '"': 5.0, '#': 8.0, '$': 7.0, '%': 11.0, '&': 8.0,
+	'\'': 3.0, '(': 4.8, ')': 4.8, '*': 5.5, '+': 8.0, ',': 4.0, '-': 5.0,
+	'.': 4.0, '/': 4.0, '0': 7.0, '1': 7.0, '2': 7.0, '3': 7.0, '4': 7.0,
+	'5': 7.0, '6': 7.0, '7': 7.0, '8': 7.0, '9': 7.0, ':': 4.5, ';': 4.5,
+	'<': 8.0, '=': 8.0, '>': 8.0, '?': 6.0, '@': 12.0,
+	'A': 8.0, 'B': 8.0, 'C': 8.0, 'D': 8.5, 'E': 7.5, 'F': 7.0, 'G': 9.0,
+	'H': 8.5, 'I': 4.0, 'J': 4.0, 'K': 8.0, 'L': 7.0, 'M': 10.0, 'N': 8.5,
+	'O': 9.0, 'P': 7.5, 'Q': 9.0, 'R': 8.0, 'S': 7.5, 'T': 7.0, 'U': 8.5,
+	'V': 8.0, 'W': 11.5, 'X': 8.0, 'Y': 8.0, 'Z': 7.5,
+	'a': 6.5, 'b': 7.0, 'c': 6.0, 'd': 7.0, 'e': 6.5, 'f': 4.0, 'g': 7.0,
+	'h': 7.0, 'i': 3.0, 'j': 3.0, 'k': 6.5, 'l': 3.0, 'm': 10.5, 'n': 7.0,
+	'o': 7.0, 'p': 7.0, 'q': 7.0, 'r': 5.0, 's': 6.0, 't': 4.5, 'u': 7.0,
+	'v': 6.0, 'w': 9.0, 'x': 6.0, 'y': 6.0, 'z': 6.0,
+	'₽': 8.0, '€': 8.0, '▲': 8.0, '▼': 8.0,
+}
+
+const fallbackWidth = 7.0
+
+// textWidth estimates the rendered pixel width of s at the badge's base
+// font size, used to size each badge panel.
+func textWidth(s string) float64 {
+	var width float64
+	for _, r := range s {
+		if w, ok := charWidths[r]; ok {
+			width += w
+			continue
+		}
+		width += fallbackWidth
+	}
+	return width
+}
@@ -0,0 +1,133 @@
+package providers
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/wtf-keaton/usd-rate-chart/metrics"
+)
+
+const (
+	ecbDailyURL      = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+	ecbHistoricalURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist-90d.xml"
+	ecbHTTPTimeout   = 5 * time.Second
+	ecbProviderName  = "ecb"
+)
+
+// ecbEnvelope mirrors the gesmes:Envelope structure returned by the ECB
+// eurofxref feeds. All rates are quoted against EUR.
+type ecbEnvelope struct {
+	Cubes []ecbDayCube `xml:"Cube>Cube"`
+}
+
+type ecbDayCube struct {
+	Time  string        `xml:"time,attr"`
+	Rates []ecbRateCube `xml:"Cube"`
+}
+
+type ecbRateCube struct {
+	Currency string  `xml:"currency,attr"`
+	Rate     float64 `xml:"rate,attr"`
+}
+
+// ECBProvider fetches rates from the European Central Bank's eurofxref feed.
+type ECBProvider struct {
+	client *http.Client
+}
+
+// NewECBProvider creates an ECB-backed RateProvider.
+func NewECBProvider() *ECBProvider {
+	return &ECBProvider{client: &http.Client{Timeout: ecbHTTPTimeout}}
+}
+
+func (p *ECBProvider) Name() string {
+	return "ecb"
+}
+
+func (p *ECBProvider) GetRate(ctx context.Context, base, quote string) (Rate, error) {
+	env, err := p.fetch(ctx, ecbDailyURL)
+	if err != nil {
+		return Rate{}, err
+	}
+	if len(env.Cubes) == 0 {
+		return Rate{}, fmt.Errorf("ecb: empty response")
+	}
+
+	day := env.Cubes[0]
+	eurRates := eurRatesOf(day.Rates)
+
+	value, err := CrossRate(eurRates, base, quote, ecbProviderName)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	return Rate{Base: base, Quote: quote, Value: value, Date: day.Time}, nil
+}
+
+func (p *ECBProvider) GetHistory(ctx context.Context, base, quote string, from, to time.Time) ([]HistoryPoint, error) {
+	env, err := p.fetch(ctx, ecbHistoricalURL)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]HistoryPoint, 0, len(env.Cubes))
+	for _, day := range env.Cubes {
+		date, err := time.Parse("2006-01-02", day.Time)
+		if err != nil || date.Before(from) || date.After(to) {
+			continue
+		}
+
+		value, err := CrossRate(eurRatesOf(day.Rates), base, quote, ecbProviderName)
+		if err != nil {
+			continue
+		}
+		history = append(history, HistoryPoint{Date: day.Time, Value: value})
+	}
+
+	return history, nil
+}
+
+func (p *ECBProvider) fetch(ctx context.Context, url string) (env *ecbEnvelope, err error) {
+	start := time.Now()
+	defer func() {
+		metrics.ObserveLatency(ecbProviderName, time.Since(start))
+		metrics.RecordFetch(ecbProviderName, err)
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, ecbHTTPTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ecb: failed to create request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ecb: failed to fetch data: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ecb: unexpected status code: %d", resp.StatusCode)
+	}
+
+	var result ecbEnvelope
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("ecb: failed to parse XML: %w", err)
+	}
+
+	return &result, nil
+}
+
+func eurRatesOf(cubes []ecbRateCube) map[string]float64 {
+	rates := make(map[string]float64, len(cubes)+1)
+	rates["EUR"] = 1
+	for _, c := range cubes {
+		rates[c.Currency] = c.Rate
+	}
+	return rates
+}
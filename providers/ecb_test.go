@@ -0,0 +1,22 @@
+package providers
+
+import "testing"
+
+func TestEurRatesOf(t *testing.T) {
+	cubes := []ecbRateCube{
+		{Currency: "USD", Rate: 1.1},
+		{Currency: "GBP", Rate: 0.85},
+	}
+
+	got := eurRatesOf(cubes)
+
+	if got["EUR"] != 1 {
+		t.Errorf("eurRatesOf: EUR = %v, want 1", got["EUR"])
+	}
+	if got["USD"] != 1.1 {
+		t.Errorf("eurRatesOf: USD = %v, want 1.1", got["USD"])
+	}
+	if got["GBP"] != 0.85 {
+		t.Errorf("eurRatesOf: GBP = %v, want 0.85", got["GBP"])
+	}
+}
@@ -0,0 +1,116 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/wtf-keaton/usd-rate-chart/metrics"
+)
+
+const (
+	exchangeRateHostBaseURL  = "https://api.exchangerate.host"
+	exchangeRateHTTPTimeout  = 5 * time.Second
+	exchangeRateProviderName = "exchangerate.host"
+)
+
+// exchangeRateHostLatest mirrors the relevant fields of /latest responses.
+type exchangeRateHostLatest struct {
+	Date  string             `json:"date"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+// exchangeRateHostTimeseries mirrors the relevant fields of /timeseries responses.
+type exchangeRateHostTimeseries struct {
+	Rates map[string]map[string]float64 `json:"rates"`
+}
+
+// ExchangeRateHostProvider fetches rates from the exchangerate.host JSON API.
+// Unlike cbr and ecb it supports any base/quote pair directly.
+type ExchangeRateHostProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewExchangeRateHostProvider creates an exchangerate.host-backed RateProvider.
+func NewExchangeRateHostProvider() *ExchangeRateHostProvider {
+	return &ExchangeRateHostProvider{
+		client:  &http.Client{Timeout: exchangeRateHTTPTimeout},
+		baseURL: exchangeRateHostBaseURL,
+	}
+}
+
+func (p *ExchangeRateHostProvider) Name() string {
+	return "exchangerate.host"
+}
+
+func (p *ExchangeRateHostProvider) GetRate(ctx context.Context, base, quote string) (Rate, error) {
+	url := fmt.Sprintf("%s/latest?base=%s&symbols=%s", p.baseURL, base, quote)
+
+	var payload exchangeRateHostLatest
+	if err := p.getJSON(ctx, url, &payload); err != nil {
+		return Rate{}, err
+	}
+
+	value, ok := payload.Rates[quote]
+	if !ok {
+		return Rate{}, fmt.Errorf("exchangerate.host: no rate for %s/%s", base, quote)
+	}
+
+	return Rate{Base: base, Quote: quote, Value: value, Date: payload.Date}, nil
+}
+
+func (p *ExchangeRateHostProvider) GetHistory(ctx context.Context, base, quote string, from, to time.Time) ([]HistoryPoint, error) {
+	url := fmt.Sprintf("%s/timeseries?base=%s&symbols=%s&start_date=%s&end_date=%s",
+		p.baseURL, base, quote, from.Format("2006-01-02"), to.Format("2006-01-02"))
+
+	var payload exchangeRateHostTimeseries
+	if err := p.getJSON(ctx, url, &payload); err != nil {
+		return nil, err
+	}
+
+	history := make([]HistoryPoint, 0, len(payload.Rates))
+	for date, rates := range payload.Rates {
+		value, ok := rates[quote]
+		if !ok {
+			continue
+		}
+		history = append(history, HistoryPoint{Date: date, Value: value})
+	}
+
+	return history, nil
+}
+
+func (p *ExchangeRateHostProvider) getJSON(ctx context.Context, url string, out interface{}) (err error) {
+	start := time.Now()
+	defer func() {
+		metrics.ObserveLatency(exchangeRateProviderName, time.Since(start))
+		metrics.RecordFetch(exchangeRateProviderName, err)
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, exchangeRateHTTPTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("exchangerate.host: failed to create request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("exchangerate.host: failed to fetch data: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("exchangerate.host: unexpected status code: %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("exchangerate.host: failed to parse JSON: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Package providers defines the pluggable rate data source contract used by
+// CurrencyService, along with the shared types providers exchange.
+package providers
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Rate is a single currency quote returned by a provider.
+type Rate struct {
+	Base  string  `json:"base"`
+	Quote string  `json:"quote"`
+	Value float64 `json:"value"`
+	Date  string  `json:"date"`
+}
+
+// HistoryPoint is one day's worth of a rate history series.
+type HistoryPoint struct {
+	Date  string  `json:"date"`
+	Value float64 `json:"value"`
+}
+
+// RateFetcher is the subset of CurrencyService needed by consumers that only
+// fetch a single rate (the scheduler, the websocket hub). It is defined here
+// rather than in the service package so those consumers can depend on it
+// without pulling in CurrencyService's own dependencies.
+type RateFetcher interface {
+	GetRateValue(ctx context.Context, base, quote string) (float64, error)
+}
+
+// RateProvider is implemented by every upstream rate source (CBR, ECB,
+// exchangerate.host, ...). CurrencyService queries providers in order and
+// falls back to the next one when a provider returns an error.
+type RateProvider interface {
+	// Name identifies the provider in logs and configuration.
+	Name() string
+	// GetRate returns the latest known rate for base/quote.
+	GetRate(ctx context.Context, base, quote string) (Rate, error)
+	// GetHistory returns the rate history for base/quote between from and to, inclusive.
+	GetHistory(ctx context.Context, base, quote string, from, to time.Time) ([]HistoryPoint, error)
+}
+
+// CrossRate computes the base->quote rate from a map of currency code to its
+// rate against a common reference currency (e.g. RUB for cbr, EUR for ecb).
+// providerName prefixes the returned error so it can be traced back to the
+// provider that produced the rates map.
+func CrossRate(rates map[string]float64, base, quote, providerName string) (float64, error) {
+	baseRate, ok := rates[base]
+	if !ok {
+		return 0, fmt.Errorf("%s: unsupported currency %q", providerName, base)
+	}
+	quoteRate, ok := rates[quote]
+	if !ok {
+		return 0, fmt.Errorf("%s: unsupported currency %q", providerName, quote)
+	}
+	if quoteRate == 0 {
+		return 0, fmt.Errorf("%s: zero rate for %q", providerName, quote)
+	}
+	return baseRate / quoteRate, nil
+}
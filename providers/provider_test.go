@@ -0,0 +1,55 @@
+package providers
+
+import "testing"
+
+func TestCrossRate(t *testing.T) {
+	rates := map[string]float64{
+		"RUB": 1,
+		"USD": 90,
+		"EUR": 100,
+		"GBP": 0,
+	}
+
+	tests := []struct {
+		name    string
+		base    string
+		quote   string
+		want    float64
+		wantErr bool
+	}{
+		{name: "base against reference", base: "USD", quote: "RUB", want: 90},
+		{name: "reference against quote", base: "RUB", quote: "USD", want: 1.0 / 90},
+		{name: "cross two foreign currencies", base: "EUR", quote: "USD", want: 100.0 / 90},
+		{name: "unknown base", base: "CNY", quote: "RUB", wantErr: true},
+		{name: "unknown quote", base: "USD", quote: "CNY", wantErr: true},
+		{name: "zero quote rate", base: "USD", quote: "GBP", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CrossRate(rates, tt.base, tt.quote, "testprovider")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("CrossRate(%s, %s) = %v, want error", tt.base, tt.quote, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CrossRate(%s, %s) returned unexpected error: %v", tt.base, tt.quote, err)
+			}
+			if got != tt.want {
+				t.Errorf("CrossRate(%s, %s) = %v, want %v", tt.base, tt.quote, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossRateErrorUsesProviderName(t *testing.T) {
+	_, err := CrossRate(map[string]float64{}, "USD", "RUB", "ecb")
+	if err == nil {
+		t.Fatal("CrossRate with empty rates map = nil error, want error")
+	}
+	if got, want := err.Error(), `ecb: unsupported currency "USD"`; got != want {
+		t.Errorf("CrossRate error = %q, want %q", got, want)
+	}
+}
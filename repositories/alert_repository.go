@@ -0,0 +1,139 @@
+// Package repositories contains the SQLite-backed persistence layer.
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/wtf-keaton/usd-rate-chart/models"
+)
+
+// AlertRepository persists models.Alert rows in SQLite.
+type AlertRepository struct {
+	db *sql.DB
+}
+
+// NewAlertRepository opens dbPath and ensures the alerts table exists.
+func NewAlertRepository(dbPath string) (*AlertRepository, error) {
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite db: %w", err)
+	}
+
+	const schema = `
+	CREATE TABLE IF NOT EXISTS alerts (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		currency    TEXT NOT NULL,
+		threshold   REAL NOT NULL,
+		direction   TEXT NOT NULL,
+		webhook_url TEXT NOT NULL,
+		created_at  DATETIME NOT NULL
+	);`
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create alerts table: %w", err)
+	}
+
+	return &AlertRepository{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (r *AlertRepository) Close() error {
+	return r.db.Close()
+}
+
+// Create inserts alert and returns it with its assigned ID.
+func (r *AlertRepository) Create(alert models.Alert) (models.Alert, error) {
+	alert.CreatedAt = time.Now()
+
+	result, err := r.db.Exec(
+		`INSERT INTO alerts (currency, threshold, direction, webhook_url, created_at) VALUES (?, ?, ?, ?, ?)`,
+		alert.Currency, alert.Threshold, alert.Direction, alert.WebhookURL, alert.CreatedAt,
+	)
+	if err != nil {
+		return models.Alert{}, fmt.Errorf("failed to insert alert: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return models.Alert{}, fmt.Errorf("failed to read inserted id: %w", err)
+	}
+	alert.ID = id
+
+	return alert, nil
+}
+
+// GetAll returns every stored alert.
+func (r *AlertRepository) GetAll() ([]models.Alert, error) {
+	rows, err := r.db.Query(`SELECT id, currency, threshold, direction, webhook_url, created_at FROM alerts ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []models.Alert
+	for rows.Next() {
+		var a models.Alert
+		if err := rows.Scan(&a.ID, &a.Currency, &a.Threshold, &a.Direction, &a.WebhookURL, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan alert: %w", err)
+		}
+		alerts = append(alerts, a)
+	}
+
+	return alerts, rows.Err()
+}
+
+// GetByID returns the alert with the given id, or sql.ErrNoRows if missing.
+func (r *AlertRepository) GetByID(id int64) (models.Alert, error) {
+	var a models.Alert
+	err := r.db.QueryRow(
+		`SELECT id, currency, threshold, direction, webhook_url, created_at FROM alerts WHERE id = ?`, id,
+	).Scan(&a.ID, &a.Currency, &a.Threshold, &a.Direction, &a.WebhookURL, &a.CreatedAt)
+	if err != nil {
+		return models.Alert{}, err
+	}
+	return a, nil
+}
+
+// Update overwrites the mutable fields of the alert identified by alert.ID.
+func (r *AlertRepository) Update(alert models.Alert) error {
+	result, err := r.db.Exec(
+		`UPDATE alerts SET currency = ?, threshold = ?, direction = ?, webhook_url = ? WHERE id = ?`,
+		alert.Currency, alert.Threshold, alert.Direction, alert.WebhookURL, alert.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update alert: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Delete removes the alert with the given id.
+func (r *AlertRepository) Delete(id int64) error {
+	result, err := r.db.Exec(`DELETE FROM alerts WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete alert: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+// Package scheduler periodically refreshes rates and fires webhooks for
+// alerts whose thresholds have been crossed.
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/wtf-keaton/usd-rate-chart/models"
+	"github.com/wtf-keaton/usd-rate-chart/providers"
+)
+
+const (
+	webhookTimeout   = 5 * time.Second
+	webhookMaxRetry  = 3
+	webhookRetryBase = 500 * time.Millisecond
+)
+
+// AlertStore is the subset of AlertRepository the scheduler needs.
+type AlertStore interface {
+	GetAll() ([]models.Alert, error)
+}
+
+// Scheduler runs a cron job that checks every stored alert against the
+// latest rates and notifies triggered alerts' webhooks.
+type Scheduler struct {
+	cron   *cron.Cron
+	rates  providers.RateFetcher
+	alerts AlertStore
+	client *http.Client
+}
+
+// New creates a Scheduler; call Start to begin running it.
+func New(rates providers.RateFetcher, alerts AlertStore) *Scheduler {
+	return &Scheduler{
+		cron:   cron.New(),
+		rates:  rates,
+		alerts: alerts,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Start schedules the refresh job on spec, a standard cron expression such as
+// "@every 5m", and begins running it in the background.
+func (s *Scheduler) Start(spec string) error {
+	_, err := s.cron.AddFunc(spec, s.refresh)
+	if err != nil {
+		return fmt.Errorf("scheduler: invalid cron spec %q: %w", spec, err)
+	}
+	s.cron.Start()
+	return nil
+}
+
+// Stop halts the cron job, waiting for any in-flight run to finish.
+func (s *Scheduler) Stop() {
+	<-s.cron.Stop().Done()
+}
+
+func (s *Scheduler) refresh() {
+	alerts, err := s.alerts.GetAll()
+	if err != nil {
+		log.Printf("scheduler: failed to load alerts: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+
+	for _, alert := range alerts {
+		base, quote, err := models.SplitCurrencyPair(alert.Currency)
+		if err != nil {
+			log.Printf("scheduler: alert %d: %v", alert.ID, err)
+			continue
+		}
+
+		rate, err := s.rates.GetRateValue(ctx, base, quote)
+		if err != nil {
+			log.Printf("scheduler: failed to fetch rate for alert %d: %v", alert.ID, err)
+			continue
+		}
+
+		if alert.Triggered(rate) {
+			s.notify(alert, rate)
+		}
+	}
+}
+
+func (s *Scheduler) notify(alert models.Alert, rate float64) {
+	payload, err := json.Marshal(fiberAlertPayload{
+		Currency:  alert.Currency,
+		Rate:      rate,
+		Threshold: alert.Threshold,
+		Direction: alert.Direction,
+	})
+	if err != nil {
+		log.Printf("scheduler: failed to marshal webhook payload for alert %d: %v", alert.ID, err)
+		return
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < webhookMaxRetry; attempt++ {
+		if attempt > 0 {
+			time.Sleep(webhookRetryBase * time.Duration(1<<uint(attempt-1)))
+		}
+
+		req, err := http.NewRequest(http.MethodPost, alert.WebhookURL, bytes.NewReader(payload))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := s.client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return
+		}
+		lastErr = fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	log.Printf("scheduler: giving up on webhook for alert %d after %d attempts: %v", alert.ID, webhookMaxRetry, lastErr)
+}
+
+type fiberAlertPayload struct {
+	Currency  string           `json:"currency"`
+	Rate      float64          `json:"rate"`
+	Threshold float64          `json:"threshold"`
+	Direction models.Direction `json:"direction"`
+}
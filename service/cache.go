@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wtf-keaton/usd-rate-chart/metrics"
+)
+
+// Cache is the two-tier cache contract implemented by MemoryCache and
+// RedisCache. Values are opaque bytes so either backend can store them
+// without the cache layer knowing about providers.Rate/HistoryPoint.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, value []byte, expiration time.Duration)
+	// Invalidate removes every cached entry whose key starts with prefix.
+	Invalidate(ctx context.Context, prefix string) error
+}
+
+// MemoryCache is an in-process Cache backed by a map. It is the default
+// backend and requires no external dependency.
+type MemoryCache struct {
+	mu         sync.RWMutex
+	data       map[string][]byte
+	expiration map[string]time.Time
+}
+
+// NewMemoryCache creates an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		data:       make(map[string][]byte),
+		expiration: make(map[string]time.Time),
+	}
+}
+
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	exp, exists := c.expiration[key]
+	if !exists || time.Now().After(exp) {
+		metrics.RecordCache(key, false)
+		return nil, false
+	}
+
+	val, exists := c.data[key]
+	metrics.RecordCache(key, exists)
+	return val, exists
+}
+
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte, expiration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.data[key] = value
+	c.expiration[key] = time.Now().Add(expiration)
+}
+
+func (c *MemoryCache) Invalidate(_ context.Context, prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.data {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.data, key)
+			delete(c.expiration, key)
+		}
+	}
+
+	return nil
+}
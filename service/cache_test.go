@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheGetSet(t *testing.T) {
+	c := NewMemoryCache()
+	ctx := context.Background()
+
+	if _, found := c.Get(ctx, "rate:USD:RUB"); found {
+		t.Fatalf("Get on empty cache found a value")
+	}
+
+	c.Set(ctx, "rate:USD:RUB", []byte("90.12"), time.Minute)
+
+	val, found := c.Get(ctx, "rate:USD:RUB")
+	if !found {
+		t.Fatalf("Get after Set did not find the value")
+	}
+	if string(val) != "90.12" {
+		t.Errorf("Get = %q, want %q", val, "90.12")
+	}
+}
+
+func TestMemoryCacheGetExpired(t *testing.T) {
+	c := NewMemoryCache()
+	ctx := context.Background()
+
+	c.Set(ctx, "rate:USD:RUB", []byte("90.12"), -time.Minute)
+
+	if _, found := c.Get(ctx, "rate:USD:RUB"); found {
+		t.Fatalf("Get returned an expired value")
+	}
+}
+
+func TestMemoryCacheInvalidatePrefix(t *testing.T) {
+	c := NewMemoryCache()
+	ctx := context.Background()
+
+	c.Set(ctx, "rate:USD:RUB", []byte("a"), time.Minute)
+	c.Set(ctx, "rate:EUR:RUB", []byte("b"), time.Minute)
+	c.Set(ctx, "history:USD:RUB", []byte("c"), time.Minute)
+
+	if err := c.Invalidate(ctx, "rate:"); err != nil {
+		t.Fatalf("Invalidate returned unexpected error: %v", err)
+	}
+
+	if _, found := c.Get(ctx, "rate:USD:RUB"); found {
+		t.Errorf("rate:USD:RUB survived Invalidate(\"rate:\")")
+	}
+	if _, found := c.Get(ctx, "rate:EUR:RUB"); found {
+		t.Errorf("rate:EUR:RUB survived Invalidate(\"rate:\")")
+	}
+	if _, found := c.Get(ctx, "history:USD:RUB"); !found {
+		t.Errorf("history:USD:RUB was wrongly removed by Invalidate(\"rate:\")")
+	}
+}
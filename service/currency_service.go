@@ -0,0 +1,196 @@
+// Package service implements the rate-fetching business logic on top of
+// the providers package.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/wtf-keaton/usd-rate-chart/cbr"
+	"github.com/wtf-keaton/usd-rate-chart/metrics"
+	"github.com/wtf-keaton/usd-rate-chart/providers"
+)
+
+const (
+	CacheExpiration = 1 * time.Hour
+	// negativeCacheExpiration is how long an all-providers-failed result is
+	// remembered, so an upstream outage doesn't turn into a request storm.
+	negativeCacheExpiration = 30 * time.Second
+)
+
+// CurrencyService queries a list of providers in order, falling back to the
+// next one when the current provider fails. Concurrent cache misses for the
+// same key are coalesced into a single upstream fetch.
+type CurrencyService struct {
+	providers []providers.RateProvider
+	cache     Cache
+	group     singleflight.Group
+}
+
+// NewCurrencyService builds a CurrencyService that tries each provider in
+// order until one succeeds, caching results in cache.
+func NewCurrencyService(providerList []providers.RateProvider, cache Cache) *CurrencyService {
+	return &CurrencyService{
+		providers: providerList,
+		cache:     cache,
+	}
+}
+
+func (s *CurrencyService) GetRate(ctx context.Context, base, quote string) (providers.Rate, error) {
+	cacheKey := fmt.Sprintf("rate:%s:%s", base, quote)
+
+	if data, found := s.cache.Get(ctx, cacheKey); found {
+		var rate providers.Rate
+		if err := json.Unmarshal(data, &rate); err == nil {
+			return rate, nil
+		}
+	}
+
+	if _, failed := s.cache.Get(ctx, negativeCacheKey(cacheKey)); failed {
+		return providers.Rate{}, fmt.Errorf("all providers failed for %s/%s: cached failure, retry later", base, quote)
+	}
+
+	result, err, _ := s.group.Do(cacheKey, func() (interface{}, error) {
+		return s.fetchRate(ctx, base, quote)
+	})
+	if err != nil {
+		s.cache.Set(ctx, negativeCacheKey(cacheKey), []byte("1"), negativeCacheExpiration)
+		return providers.Rate{}, err
+	}
+
+	rate := result.(providers.Rate)
+	if data, marshalErr := json.Marshal(rate); marshalErr == nil {
+		s.cache.Set(ctx, cacheKey, data, CacheExpiration)
+	}
+	metrics.SetLastRate(base, quote, rate.Value)
+
+	return rate, nil
+}
+
+func (s *CurrencyService) fetchRate(ctx context.Context, base, quote string) (providers.Rate, error) {
+	var lastErr error
+	for _, p := range s.providers {
+		rate, err := p.GetRate(ctx, base, quote)
+		if err != nil {
+			log.Printf("provider %s failed for %s/%s: %v", p.Name(), base, quote, err)
+			lastErr = err
+			continue
+		}
+		return rate, nil
+	}
+
+	return providers.Rate{}, fmt.Errorf("all providers failed for %s/%s: %w", base, quote, lastErr)
+}
+
+func (s *CurrencyService) GetHistory(ctx context.Context, base, quote string, from, to time.Time) ([]providers.HistoryPoint, error) {
+	cacheKey := fmt.Sprintf("history:%s:%s:%s:%s", base, quote, from.Format("2006-01-02"), to.Format("2006-01-02"))
+
+	if data, found := s.cache.Get(ctx, cacheKey); found {
+		var history []providers.HistoryPoint
+		if err := json.Unmarshal(data, &history); err == nil {
+			return history, nil
+		}
+	}
+
+	if _, failed := s.cache.Get(ctx, negativeCacheKey(cacheKey)); failed {
+		return nil, fmt.Errorf("all providers failed for %s/%s history: cached failure, retry later", base, quote)
+	}
+
+	result, err, _ := s.group.Do(cacheKey, func() (interface{}, error) {
+		return s.fetchHistory(ctx, base, quote, from, to)
+	})
+	if err != nil {
+		s.cache.Set(ctx, negativeCacheKey(cacheKey), []byte("1"), negativeCacheExpiration)
+		return nil, err
+	}
+
+	history := result.([]providers.HistoryPoint)
+	if data, marshalErr := json.Marshal(history); marshalErr == nil {
+		s.cache.Set(ctx, cacheKey, data, CacheExpiration)
+	}
+
+	return history, nil
+}
+
+func (s *CurrencyService) fetchHistory(ctx context.Context, base, quote string, from, to time.Time) ([]providers.HistoryPoint, error) {
+	var lastErr error
+	for _, p := range s.providers {
+		history, err := p.GetHistory(ctx, base, quote, from, to)
+		if err != nil {
+			log.Printf("provider %s failed for %s/%s history: %v", p.Name(), base, quote, err)
+			lastErr = err
+			continue
+		}
+		return history, nil
+	}
+
+	return nil, fmt.Errorf("all providers failed for %s/%s history: %w", base, quote, lastErr)
+}
+
+// GetRateValue is a convenience wrapper around GetRate for callers that only
+// need the numeric rate, such as the alert scheduler and the websocket hub.
+func (s *CurrencyService) GetRateValue(ctx context.Context, base, quote string) (float64, error) {
+	rate, err := s.GetRate(ctx, base, quote)
+	if err != nil {
+		return 0, err
+	}
+	return rate.Value, nil
+}
+
+// InvalidateCache drops every cache entry whose key starts with prefix,
+// e.g. "rate:USD" or "history:".
+func (s *CurrencyService) InvalidateCache(ctx context.Context, prefix string) error {
+	return s.cache.Invalidate(ctx, prefix)
+}
+
+func negativeCacheKey(cacheKey string) string {
+	return "err:" + cacheKey
+}
+
+// BuildProviders resolves configured provider names into RateProvider
+// instances, preserving the configured fallback order.
+func BuildProviders(order []string) ([]providers.RateProvider, error) {
+	available := map[string]providers.RateProvider{
+		"cbr":               cbr.NewProvider(),
+		"ecb":               providers.NewECBProvider(),
+		"exchangerate.host": providers.NewExchangeRateHostProvider(),
+	}
+
+	var resolved []providers.RateProvider
+	for _, name := range order {
+		name = strings.ToLower(name)
+		p, ok := available[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown provider %q", name)
+		}
+		resolved = append(resolved, p)
+	}
+
+	if len(resolved) == 0 {
+		return nil, errors.New("no rate providers configured")
+	}
+
+	return resolved, nil
+}
+
+// BuildCache resolves the configured cache backend.
+func BuildCache(backend, redisAddr string) (Cache, error) {
+	switch strings.ToLower(backend) {
+	case "", "memory":
+		return NewMemoryCache(), nil
+	case "redis":
+		if redisAddr == "" {
+			return nil, errors.New("REDIS_ADDR must be set when CACHE_BACKEND=redis")
+		}
+		return NewRedisCache(redisAddr), nil
+	default:
+		return nil, fmt.Errorf("unknown cache backend %q", backend)
+	}
+}
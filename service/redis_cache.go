@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/wtf-keaton/usd-rate-chart/metrics"
+)
+
+// RedisCache is a Cache backend shared across process instances, selected
+// via CACHE_BACKEND=redis.
+type RedisCache struct {
+	client *redis.Client
+}
+
+// NewRedisCache connects to the Redis instance at addr.
+func NewRedisCache(addr string) *RedisCache {
+	return &RedisCache{
+		client: redis.NewClient(&redis.Options{Addr: addr}),
+	}
+}
+
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	value, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			log.Printf("redis: get %q failed: %v", key, err)
+		}
+		metrics.RecordCache(key, false)
+		return nil, false
+	}
+	metrics.RecordCache(key, true)
+	return value, true
+}
+
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, expiration time.Duration) {
+	if err := c.client.Set(ctx, key, value, expiration).Err(); err != nil {
+		log.Printf("redis: set %q failed: %v", key, err)
+	}
+}
+
+func (c *RedisCache) Invalidate(ctx context.Context, prefix string) error {
+	var keys []string
+
+	iter := c.client.Scan(ctx, 0, prefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("redis: scan %q failed: %w", prefix, err)
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("redis: del failed: %w", err)
+	}
+
+	return nil
+}
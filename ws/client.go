@@ -0,0 +1,132 @@
+package ws
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+)
+
+const (
+	sendBufferSize = 16
+	pongWait       = 60 * time.Second
+	pingInterval   = (pongWait * 9) / 10
+	writeWait      = 5 * time.Second
+)
+
+// subscribeMessage is the inbound JSON frame clients send to change which
+// currencies they receive updates for.
+type subscribeMessage struct {
+	Action   string `json:"action"` // "subscribe" or "unsubscribe"
+	Currency string `json:"currency"`
+}
+
+// Client is a single connected websocket subscriber. Outbound frames are
+// buffered on send; if a client falls behind, new frames are dropped rather
+// than blocking the broadcaster.
+type Client struct {
+	hub  *Hub
+	conn *websocket.Conn
+	send chan []byte
+
+	mu   sync.Mutex
+	subs map[string]bool
+}
+
+func newClient(hub *Hub, conn *websocket.Conn) *Client {
+	return &Client{
+		hub:  hub,
+		conn: conn,
+		send: make(chan []byte, sendBufferSize),
+		subs: make(map[string]bool),
+	}
+}
+
+// subscribed reports whether the client wants updates for currency. An empty
+// subscription set means "all currencies".
+func (c *Client) subscribed(currency string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.subs) == 0 {
+		return true
+	}
+	return c.subs[currency]
+}
+
+// enqueue attempts a non-blocking send; it drops the frame if the client's
+// buffer is full instead of blocking the rest of the hub.
+func (c *Client) enqueue(message []byte) {
+	select {
+	case c.send <- message:
+	default:
+		log.Printf("ws: dropping frame for slow client")
+	}
+}
+
+// readPump processes inbound subscribe/unsubscribe messages and keepalive
+// pongs until the connection closes.
+func (c *Client) readPump() {
+	defer func() {
+		c.hub.unregister <- c
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var msg subscribeMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+
+		c.mu.Lock()
+		switch msg.Action {
+		case "subscribe":
+			c.subs[msg.Currency] = true
+		case "unsubscribe":
+			delete(c.subs, msg.Currency)
+		}
+		c.mu.Unlock()
+	}
+}
+
+// writePump flushes buffered frames and sends periodic pings until the
+// connection closes.
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingInterval)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage, nil)
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
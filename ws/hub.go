@@ -0,0 +1,139 @@
+// Package ws implements the /ws/rates live-rate broadcast endpoint: a
+// single poller diffs upstream rates against their last known value and
+// fans the result out to subscribed clients.
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+
+	"github.com/wtf-keaton/usd-rate-chart/providers"
+)
+
+// Pair is a currency pair the hub polls and broadcasts updates for.
+type Pair struct {
+	Base  string
+	Quote string
+}
+
+// rateUpdate is the JSON frame broadcast to subscribed clients.
+type rateUpdate struct {
+	Currency string  `json:"currency"`
+	Rate     float64 `json:"rate"`
+	Date     string  `json:"date"`
+	DeltaPct float64 `json:"delta_pct"`
+}
+
+// Hub tracks connected clients and polls rates on a fixed interval,
+// broadcasting a frame whenever a tracked pair's rate changes.
+type Hub struct {
+	rates providers.RateFetcher
+	pairs []Pair
+
+	register   chan *Client
+	unregister chan *Client
+
+	mu      sync.RWMutex
+	clients map[*Client]struct{}
+	last    map[string]float64
+}
+
+// NewHub creates a Hub that polls rates and fans them out to clients.
+func NewHub(rates providers.RateFetcher, pairs []Pair) *Hub {
+	return &Hub{
+		rates:      rates,
+		pairs:      pairs,
+		register:   make(chan *Client),
+		unregister: make(chan *Client),
+		clients:    make(map[*Client]struct{}),
+		last:       make(map[string]float64),
+	}
+}
+
+// Run polls rates every interval and services client (un)registrations
+// until ctx is cancelled.
+func (h *Hub) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case client := <-h.register:
+			h.mu.Lock()
+			h.clients[client] = struct{}{}
+			h.mu.Unlock()
+		case client := <-h.unregister:
+			h.mu.Lock()
+			delete(h.clients, client)
+			h.mu.Unlock()
+			close(client.send)
+		case <-ticker.C:
+			h.poll(ctx)
+		}
+	}
+}
+
+func (h *Hub) poll(ctx context.Context) {
+	for _, pair := range h.pairs {
+		currency := pair.Base + "/" + pair.Quote
+
+		rate, err := h.rates.GetRateValue(ctx, pair.Base, pair.Quote)
+		if err != nil {
+			log.Printf("ws: failed to fetch %s: %v", currency, err)
+			continue
+		}
+
+		h.mu.Lock()
+		previous, known := h.last[currency]
+		h.last[currency] = rate
+		h.mu.Unlock()
+
+		var deltaPct float64
+		if known && previous != 0 {
+			deltaPct = (rate - previous) / previous * 100
+		}
+		if known && rate == previous {
+			continue
+		}
+
+		frame, err := json.Marshal(rateUpdate{
+			Currency: currency,
+			Rate:     rate,
+			Date:     time.Now().Format("2006-01-02"),
+			DeltaPct: deltaPct,
+		})
+		if err != nil {
+			log.Printf("ws: failed to marshal update for %s: %v", currency, err)
+			continue
+		}
+
+		h.broadcast(currency, frame)
+	}
+}
+
+func (h *Hub) broadcast(currency string, frame []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for client := range h.clients {
+		if client.subscribed(currency) {
+			client.enqueue(frame)
+		}
+	}
+}
+
+// Serve upgrades conn into a tracked client and blocks until it disconnects.
+func (h *Hub) Serve(conn *websocket.Conn) {
+	client := newClient(h, conn)
+	h.register <- client
+
+	go client.writePump()
+	client.readPump()
+}